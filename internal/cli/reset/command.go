@@ -1,6 +1,7 @@
 package reset
 
 import (
+	stderr "errors"
 	"log"
 	"sync"
 
@@ -43,7 +44,12 @@ func NewCommand(cfgFile *string, override *[]string, silent *bool) *cobra.Comman
 
 			_, _ = sdnotify.SdNotify(sdnotify.Reloading)
 
-			var wg sync.WaitGroup
+			var (
+				wg   sync.WaitGroup
+				mu   sync.Mutex
+				errs []error
+			)
+
 			wg.Add(len(plugins))
 
 			for _, plugin := range plugins {
@@ -55,10 +61,14 @@ func NewCommand(cfgFile *string, override *[]string, silent *bool) *cobra.Comman
 					defer wg.Done()
 
 					var done bool
-					<-client.Go(resetterReset, p, &done, nil).Done
+					call := <-client.Go(resetterReset, p, &done, nil).Done
+
+					if call.Error != nil {
+						log.Printf("failed to reset plugin: [%s], error: %v", p, call.Error)
 
-					if err != nil {
-						log.Println(err)
+						mu.Lock()
+						errs = append(errs, call.Error)
+						mu.Unlock()
 
 						return
 					}
@@ -73,6 +83,10 @@ func NewCommand(cfgFile *string, override *[]string, silent *bool) *cobra.Comman
 
 			_, _ = sdnotify.SdNotify(sdnotify.Ready)
 
+			if len(errs) > 0 {
+				return errors.E(op, stderr.Join(errs...))
+			}
+
 			return nil
 		},
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/roadrunner-server/errors"
 	"github.com/roadrunner-server/roadrunner/v2024/internal/cli/jobs"
 	"github.com/roadrunner-server/roadrunner/v2024/internal/cli/reset"
+	"github.com/roadrunner-server/roadrunner/v2024/internal/cli/schema"
 	"github.com/roadrunner-server/roadrunner/v2024/internal/cli/serve"
 	"github.com/roadrunner-server/roadrunner/v2024/internal/cli/stop"
 	"github.com/roadrunner-server/roadrunner/v2024/internal/cli/workers"
@@ -28,6 +29,8 @@ const (
 	// env var name: path to the .env file
 	envDotenv   string = "DOTENV_PATH"
 	pidFileName string = ".pid"
+	// default debug server address, loopback only
+	debugAddr string = "127.0.0.1:6061"
 )
 
 // NewCommand creates root command.
@@ -51,6 +54,8 @@ func NewCommand(cmdName string) *cobra.Command { //nolint:funlen,gocognit
 	var dotenv string
 	// debug mode
 	var debug bool
+	// debug server address
+	var debugAddress string
 
 	cmd := &cobra.Command{
 		Use:           cmdName,
@@ -102,7 +107,7 @@ func NewCommand(cmdName string) *cobra.Command { //nolint:funlen,gocognit
 				signal.Notify(exit, os.Interrupt, syscall.SIGTERM, syscall.SIGINT, syscall.SIGABRT)
 
 				go func() {
-					errS := srv.Start(":6061")
+					errS := srv.Start(debugAddress)
 					// errS is always non-nil, this is just double check
 					if errS != nil && stderr.Is(errS, http.ErrServerClosed) {
 						return
@@ -155,6 +160,7 @@ func NewCommand(cmdName string) *cobra.Command { //nolint:funlen,gocognit
 	f.StringVarP(&workDir, "WorkDir", "w", "", "working directory")
 	f.StringVarP(&dotenv, "dotenv", "", "", fmt.Sprintf("dotenv file [$%s]", envDotenv))
 	f.BoolVarP(&debug, "debug", "d", false, "debug mode")
+	f.StringVarP(&debugAddress, "debug-addr", "", debugAddr, "debug server (pprof) address")
 	f.BoolVarP(silent, "silent", "s", false, "do not print startup message")
 	f.StringArrayVarP(override, "override", "o", nil, "override config value (dot.notation=value)")
 
@@ -164,6 +170,7 @@ func NewCommand(cmdName string) *cobra.Command { //nolint:funlen,gocognit
 		serve.NewCommand(override, cfgFile, silent, experimental),
 		stop.NewCommand(silent, forceStop),
 		jobs.NewCommand(cfgFile, override, silent),
+		schema.NewCommand(),
 	)
 
 	return cmd
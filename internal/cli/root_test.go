@@ -21,6 +21,7 @@ func TestCommandSubcommands(t *testing.T) {
 		{giveName: "workers"},
 		{giveName: "reset"},
 		{giveName: "serve"},
+		{giveName: "schema"},
 	}
 
 	// get all existing subcommands and put into the map
@@ -51,6 +52,7 @@ func TestCommandFlags(t *testing.T) {
 		{giveName: "WorkDir", wantShorthand: "w", wantDefault: ""},
 		{giveName: "dotenv", wantShorthand: "", wantDefault: ""},
 		{giveName: "debug", wantShorthand: "d", wantDefault: "false"},
+		{giveName: "debug-addr", wantShorthand: "", wantDefault: "127.0.0.1:6061"},
 		{giveName: "override", wantShorthand: "o", wantDefault: "[]"},
 	}
 
@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"github.com/roadrunner-server/errors"
+	"github.com/roadrunner-server/roadrunner/v2024/schemas"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates `schema` command.
+func NewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print JSON schema of the RoadRunner configuration file",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			const op = errors.Op("schema_command")
+
+			if _, err := cmd.OutOrStdout().Write(schemas.Config); err != nil {
+				return errors.E(op, err)
+			}
+
+			return nil
+		},
+	}
+}
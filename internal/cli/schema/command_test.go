@@ -0,0 +1,29 @@
+package schema_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/roadrunner-server/roadrunner/v2024/internal/cli/schema"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandProperties(t *testing.T) {
+	cmd := schema.NewCommand()
+
+	assert.Equal(t, "schema", cmd.Use)
+	assert.NotNil(t, cmd.RunE)
+}
+
+func TestExecution(t *testing.T) {
+	cmd := schema.NewCommand()
+
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+
+	assert.NoError(t, cmd.Execute())
+	assert.True(t, json.Valid(buf.Bytes()))
+	assert.Contains(t, buf.String(), "Roadrunner config file schema version 3")
+}
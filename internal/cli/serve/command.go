@@ -16,8 +16,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func NewCommand(override *[]string, cfgFile *string, silent *bool, experimental *bool) *cobra.Command { //nolint:funlen
-	return &cobra.Command{
+func NewCommand(override *[]string, cfgFile *string, silent *bool, experimental *bool) *cobra.Command { //nolint:funlen,gocognit
+	// only initialize plugins (validate the configuration) and exit
+	var dryRun bool
+
+	cmd := &cobra.Command{
 		Use:   "serve",
 		Short: "Start RoadRunner server",
 		RunE: func(*cobra.Command, []string) error {
@@ -70,6 +73,15 @@ func NewCommand(override *[]string, cfgFile *string, silent *bool, experimental
 				return errors.E(op, err)
 			}
 
+			// all plugins were initialized, which means that their configurations were parsed and validated
+			if dryRun {
+				if !*silent {
+					fmt.Printf("[INFO] configuration is valid: %s\n", *cfgFile)
+				}
+
+				return nil
+			}
+
 			// start serving the graph
 			errCh, err := cont.Serve()
 			if err != nil {
@@ -140,4 +152,8 @@ func NewCommand(override *[]string, cfgFile *string, silent *bool, experimental
 			}
 		},
 	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "initialize all plugins to validate the configuration and exit without serving")
+
+	return cmd
 }
@@ -23,6 +23,15 @@ func TestCommandNil(t *testing.T) {
 	assert.NotNil(t, cmd.RunE)
 }
 
+func TestCommandFlags(t *testing.T) {
+	cmd := serve.NewCommand(nil, nil, nil, nil)
+
+	flag := cmd.Flag("dry-run")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, "false", flag.DefValue)
+	}
+}
+
 func TestExecution(t *testing.T) {
 	t.Skip("Command execution is not implemented yet")
 }
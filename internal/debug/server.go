@@ -2,11 +2,15 @@ package debug
 
 import (
 	"context"
+	"expvar"
 	"net/http"
 	"net/http/pprof"
+	"runtime"
 	"time"
 )
 
+const goroutinesVar string = "goroutines"
+
 // Server is a HTTP server for debugging.
 type Server struct {
 	srv *http.Server
@@ -22,6 +26,15 @@ func NewServer() Server {
 	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 
+	// expvar publishes cmdline and memstats (including GC pauses) by default
+	if expvar.Get(goroutinesVar) == nil {
+		expvar.Publish(goroutinesVar, expvar.Func(func() any {
+			return runtime.NumGoroutine()
+		}))
+	}
+
+	mux.Handle("/debug/vars", expvar.Handler())
+
 	return Server{srv: &http.Server{
 		ReadHeaderTimeout: time.Minute * 10,
 		Handler:           mux,
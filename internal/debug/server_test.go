@@ -42,6 +42,7 @@ func TestServer_StartingAndStopping(t *testing.T) {
 		// "http://127.0.0.1:" + port + "/debug/pprof/profile",
 		"http://127.0.0.1:" + port + "/debug/pprof/symbol",
 		// "http://127.0.0.1:" + port + "/debug/pprof/trace",
+		"http://127.0.0.1:" + port + "/debug/vars",
 	} {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 
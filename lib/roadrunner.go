@@ -1,6 +1,7 @@
 package lib
 
 import (
+	"context"
 	"fmt"
 	"runtime/debug"
 
@@ -72,6 +73,11 @@ func NewRR(cfgFile string, override []string, pluginList []any) (*RR, error) {
 // Serve starts RR and starts listening for requests.
 // This is a blocking call that will return an error if / when one occurs in a plugin
 func (rr *RR) Serve() error {
+	return rr.ServeContext(context.Background())
+}
+
+// ServeContext is the same as Serve, but RR is also stopped when the provided context is canceled.
+func (rr *RR) ServeContext(ctx context.Context) error {
 	// start serving the graph
 	errCh, err := rr.container.Serve()
 	if err != nil {
@@ -83,6 +89,8 @@ func (rr *RR) Serve() error {
 		return fmt.Errorf("error: %w\nplugin: %s", e.Error, e.VertexID)
 	case <-rr.stop:
 		return rr.container.Stop()
+	case <-ctx.Done():
+		return rr.container.Stop()
 	}
 }
 
@@ -1,6 +1,7 @@
 package lib_test
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
@@ -91,3 +92,33 @@ func TestServeStop(t *testing.T) {
 		_ = os.Remove(cfgFile)
 	})
 }
+
+func TestServeContextCancel(t *testing.T) {
+	cfgFile := makeConfig(t, testConfigWithVersion)
+	plugins := []any{
+		&informer.Plugin{},
+		&resetter.Plugin{},
+	}
+	rr, err := lib.NewRR(cfgFile, []string{}, plugins)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errchan := make(chan error, 1)
+
+	go func() {
+		errchan <- rr.ServeContext(ctx)
+	}()
+
+	cancel()
+
+	select {
+	case err = <-errchan:
+		assert.NoError(t, err)
+	case <-time.After(time.Second * 5):
+		t.Fatal("ServeContext was not stopped after the context cancellation")
+	}
+
+	t.Cleanup(func() {
+		_ = os.Remove(cfgFile)
+	})
+}
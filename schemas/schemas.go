@@ -0,0 +1,11 @@
+// Package schemas embeds the public configuration schemas, so they can be shipped with the binary.
+package schemas
+
+import (
+	_ "embed"
+)
+
+// Config is the JSON schema of the current (version 3) configuration file.
+//
+//go:embed config/3.0.schema.json
+var Config []byte //nolint:gochecknoglobals